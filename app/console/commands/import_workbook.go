@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/goravel/framework/contracts/console"
+	"github.com/goravel/framework/contracts/console/command"
+	"github.com/goravel/framework/facades"
+
+	"goravel/app/datasource"
+	"goravel/app/excelmap"
+	"goravel/app/http/controllers"
+	"goravel/app/models"
+)
+
+// ImportWorkbook is the `workbook:import` artisan command. It fetches
+// the named datasource ("customers" or "products"), decodes it, and
+// upserts the rows into the database by their natural key (CustId or
+// Code), so re-running the import is safe.
+type ImportWorkbook struct {
+}
+
+func NewImportWorkbook() *ImportWorkbook {
+	return &ImportWorkbook{}
+}
+
+// Signature The name and signature of the console command.
+func (r *ImportWorkbook) Signature() string {
+	return "workbook:import"
+}
+
+// Description The console command description.
+func (r *ImportWorkbook) Description() string {
+	return "Import a customers or products workbook from a configured datasource into the database"
+}
+
+// Extend The console command extend.
+func (r *ImportWorkbook) Extend() command.Extend {
+	return command.Extend{
+		Category: "workbook",
+		Flags: []command.Flag{
+			&command.StringFlag{
+				Name:     "source",
+				Aliases:  []string{"s"},
+				Usage:    "datasource name to import: customers or products",
+				Required: true,
+			},
+		},
+	}
+}
+
+// Handle Execute the console command.
+func (r *ImportWorkbook) Handle(ctx console.Context) error {
+	name := ctx.Option("source")
+
+	src, err := datasource.Resolve(facades.Config(), name)
+	if err != nil {
+		return err
+	}
+	data, err := src.Fetch(context.Background())
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "customers":
+		return r.importCustomers(data)
+	case "products":
+		return r.importProducts(data)
+	default:
+		return fmt.Errorf("workbook:import: unknown source %q, expected customers or products", name)
+	}
+}
+
+func (r *ImportWorkbook) importCustomers(data []byte) error {
+	var rows []controllers.ExlData
+	if err := decodeRows(data, &rows); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		customer := models.Customer{}
+		err := facades.Orm().Query().UpdateOrCreate(&customer,
+			models.Customer{CustId: row.CustId},
+			models.Customer{
+				Branch:    row.Branch,
+				CustId:    row.CustId,
+				CustName:  row.CustName,
+				Alamat:    row.Alamat,
+				Kota:      row.Kota,
+				SalesName: row.SalesName,
+				Channel:   row.Channel,
+				Avg2023:   row.Avg2023.Ptr(),
+				Q4Avg2023: row.Q4Avg2023.Ptr(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("workbook:import: upserting customer %q: %w", row.CustId, err)
+		}
+	}
+
+	facades.Log().Infof("workbook:import: upserted %d customers", len(rows))
+	return nil
+}
+
+func (r *ImportWorkbook) importProducts(data []byte) error {
+	var rows []controllers.ExlProduct
+	if err := decodeRows(data, &rows); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		product := models.Product{}
+		err := facades.Orm().Query().UpdateOrCreate(&product,
+			models.Product{Code: row.Code},
+			models.Product{
+				Code:        row.Code,
+				NameProduct: row.NameProduct,
+				HNA:         row.HNA.Ptr(),
+				PPN:         row.PPN.Ptr(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("workbook:import: upserting product %q: %w", row.Code, err)
+		}
+	}
+
+	facades.Log().Infof("workbook:import: upserted %d products", len(rows))
+	return nil
+}
+
+// decodeRows decodes data into out, tolerating row-level validation
+// errors (only the number of rejected rows is logged; they're not
+// fatal) since a handful of bad rows
+// shouldn't block importing the rest of a large workbook.
+func decodeRows(data []byte, out any) error {
+	return excelmap.DecodeTolerant(bytes.NewReader(data), out, func(rowsRejected int) {
+		facades.Log().Warningf("workbook:import: skipped %d invalid rows", rowsRejected)
+	})
+}
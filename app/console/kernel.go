@@ -0,0 +1,16 @@
+package console
+
+import (
+	"github.com/goravel/framework/contracts/console"
+
+	"goravel/app/console/commands"
+)
+
+type Kernel struct {
+}
+
+func (kernel Kernel) Commands() []console.Command {
+	return []console.Command{
+		commands.NewImportWorkbook(),
+	}
+}
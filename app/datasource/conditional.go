@@ -0,0 +1,14 @@
+package datasource
+
+import "context"
+
+// ConditionalSource is implemented by sources that can skip re-fetching
+// when the backing resource hasn't changed, using a revision token from
+// a previous fetch (an ETag or a Last-Modified value).
+type ConditionalSource interface {
+	Source
+
+	// FetchIfChanged re-fetches the resource unless it still matches
+	// revision, in which case changed is false and data is nil.
+	FetchIfChanged(ctx context.Context, revision string) (data []byte, newRevision string, changed bool, err error)
+}
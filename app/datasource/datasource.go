@@ -0,0 +1,44 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Source fetches the raw bytes backing a named workbook, regardless of
+// where it actually lives (local disk, HTTP(S), or S3).
+type Source interface {
+	Fetch(ctx context.Context) ([]byte, error)
+}
+
+// Options configures a Source. Not every field applies to every scheme:
+// Retries and Headers are only honoured by the http(s) source.
+type Options struct {
+	URI     string
+	Timeout time.Duration
+	Retries int
+	Headers map[string]string
+}
+
+// New builds the Source implementation matching the scheme of opts.URI,
+// e.g. "file://excel/jogja.xlsx", "https://host/file.xlsx" or
+// "s3://bucket/key.xlsx".
+func New(opts Options) (Source, error) {
+	u, err := url.Parse(opts.URI)
+	if err != nil {
+		return nil, fmt.Errorf("datasource: invalid uri %q: %w", opts.URI, err)
+	}
+
+	switch u.Scheme {
+	case "file":
+		return newFileSource(u), nil
+	case "http", "https":
+		return newHTTPSource(u, opts)
+	case "s3":
+		return newS3Source(u, opts)
+	default:
+		return nil, fmt.Errorf("datasource: unsupported scheme %q in %q", u.Scheme, opts.URI)
+	}
+}
@@ -0,0 +1,24 @@
+package datasource
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// fileSource reads a workbook from the local filesystem.
+type fileSource struct {
+	path string
+}
+
+func newFileSource(u *url.URL) *fileSource {
+	// "file://excel/jogja.xlsx" parses with the path in Host+Path, so
+	// stitch them back together rather than assuming an absolute path.
+	path := filepath.Join(u.Host, u.Path)
+	return &fileSource{path: path}
+}
+
+func (s *fileSource) Fetch(ctx context.Context) ([]byte, error) {
+	return os.ReadFile(s.path)
+}
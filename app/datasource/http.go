@@ -0,0 +1,102 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+	// maxBodyBytes caps how much of a response we'll buffer, so a
+	// misbehaving server can't exhaust memory via an unbounded body.
+	maxBodyBytes = 64 << 20 // 64MiB
+)
+
+// httpSource fetches a workbook over HTTP(S), retrying transient failures
+// and attaching any configured auth headers.
+type httpSource struct {
+	url     string
+	client  *http.Client
+	retries int
+	headers map[string]string
+}
+
+func newHTTPSource(u *url.URL, opts Options) (*httpSource, error) {
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &httpSource{
+		url:     u.String(),
+		client:  &http.Client{Timeout: timeout},
+		retries: opts.Retries,
+		headers: opts.Headers,
+	}, nil
+}
+
+func (s *httpSource) Fetch(ctx context.Context) ([]byte, error) {
+	body, _, _, err := s.fetch(ctx, "")
+	return body, err
+}
+
+// FetchIfChanged implements ConditionalSource using If-None-Match /
+// If-Modified-Since, sending revision as both since it may be either an
+// ETag or a Last-Modified value depending on what the server returned.
+func (s *httpSource) FetchIfChanged(ctx context.Context, revision string) ([]byte, string, bool, error) {
+	return s.fetch(ctx, revision)
+}
+
+func (s *httpSource) fetch(ctx context.Context, revision string) ([]byte, string, bool, error) {
+	var lastErr error
+	for attempt := 0; attempt <= s.retries; attempt++ {
+		body, newRevision, changed, err := s.fetchOnce(ctx, revision)
+		if err == nil {
+			return body, newRevision, changed, nil
+		}
+		lastErr = err
+	}
+	return nil, "", false, fmt.Errorf("datasource: fetching %q: %w", s.url, lastErr)
+}
+
+func (s *httpSource) fetchOnce(ctx context.Context, revision string) ([]byte, string, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, "", false, err
+	}
+	for key, value := range s.headers {
+		req.Header.Set(key, value)
+	}
+	if revision != "" {
+		req.Header.Set("If-None-Match", revision)
+		req.Header.Set("If-Modified-Since", revision)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, "", false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, revision, false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", false, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	newRevision := resp.Header.Get("ETag")
+	if newRevision == "" {
+		newRevision = resp.Header.Get("Last-Modified")
+	}
+	return body, newRevision, true, nil
+}
@@ -0,0 +1,33 @@
+package datasource
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goravel/framework/contracts/config"
+)
+
+// Resolve builds the Source registered under config key
+// "datasource.<name>", e.g. Resolve(cfg, "customers") reads
+// "datasource.customers.uri" and friends.
+func Resolve(cfg config.Config, name string) (Source, error) {
+	key := "datasource." + name
+	uri := cfg.GetString(key + ".uri")
+	if uri == "" {
+		return nil, fmt.Errorf("datasource: %q is not configured (missing %s.uri)", name, key)
+	}
+
+	headers := map[string]string{}
+	if raw, ok := cfg.Get(key + ".headers").(map[string]any); ok {
+		for k, v := range raw {
+			headers[k] = fmt.Sprint(v)
+		}
+	}
+
+	return New(Options{
+		URI:     uri,
+		Timeout: time.Duration(cfg.GetInt(key+".timeout", 30)) * time.Second,
+		Retries: cfg.GetInt(key+".retries", 0),
+		Headers: headers,
+	})
+}
@@ -0,0 +1,63 @@
+package datasource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// s3Source fetches a workbook object from S3, e.g. "s3://bucket/key.xlsx".
+type s3Source struct {
+	bucket  string
+	key     string
+	timeout time.Duration
+}
+
+func newS3Source(u *url.URL, opts Options) (*s3Source, error) {
+	bucket := u.Host
+	key := strings.TrimPrefix(u.Path, "/")
+	if bucket == "" || key == "" {
+		return nil, fmt.Errorf("datasource: s3 uri %q must be of the form s3://bucket/key", u.String())
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	return &s3Source{bucket: bucket, key: key, timeout: timeout}, nil
+}
+
+func (s *s3Source) Fetch(ctx context.Context) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("datasource: loading aws config: %w", err)
+	}
+
+	client := s3.NewFromConfig(cfg)
+	out, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("datasource: fetching s3://%s/%s: %w", s.bucket, s.key, err)
+	}
+	defer out.Body.Close()
+
+	buf := &bytes.Buffer{}
+	if _, err := io.Copy(buf, out.Body); err != nil {
+		return nil, fmt.Errorf("datasource: reading s3://%s/%s: %w", s.bucket, s.key, err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,222 @@
+package excelmap
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	excelize "github.com/xuri/excelize/v2"
+)
+
+// Decode reads an XLSX workbook from r and appends one decoded element
+// per data row into out, which must be a pointer to a slice of a type
+// previously passed to Register. Rows that fail validation are
+// collected into a *ValidationError covering the whole sheet rather
+// than aborting on the first bad row.
+func Decode(r io.Reader, out any) error {
+	outVal := reflect.ValueOf(out)
+	if outVal.Kind() != reflect.Ptr || outVal.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("excelmap: out must be a pointer to a slice, got %T", out)
+	}
+	sliceVal := outVal.Elem()
+	elemType := sliceVal.Type().Elem()
+
+	spec, ok := registry[elemType]
+	if !ok {
+		return fmt.Errorf("excelmap: no SheetSpec registered for %s (call excelmap.Register first)", elemType)
+	}
+	fields := parseFields(elemType)
+
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return fmt.Errorf("excelmap: opening workbook: %w", err)
+	}
+	defer f.Close()
+
+	sheetName, rows, err := findSheet(f, spec.Sheets)
+	if err != nil {
+		return err
+	}
+
+	headerIdx, header := findHeader(rows, spec.HeaderMatch)
+	if headerIdx < 0 {
+		return fmt.Errorf("excelmap: %s: could not find a header row matching %q", sheetName, spec.HeaderMatch)
+	}
+	columns := resolveColumns(header, fields)
+
+	verr := &ValidationError{}
+	for i, row := range rows[headerIdx+1:] {
+		excelRow := headerIdx + i + 2 // 1-based, accounting for the header row itself
+		elem := reflect.New(elemType).Elem()
+		rowHasData := false
+		rowFailed := false
+
+		for _, field := range fields {
+			col, ok := columns[field.structField]
+			if !ok {
+				col = field.index
+			}
+
+			cell := ""
+			if col >= 0 && col < len(row) {
+				cell = strings.TrimSpace(row[col])
+			}
+			if cell != "" {
+				rowHasData = true
+			}
+
+			if cell == "" {
+				if field.required {
+					verr.Errors = append(verr.Errors, &FieldError{
+						Sheet: sheetName, Row: excelRow, Column: field.label(),
+						Err: fmt.Errorf("required value is empty"),
+					})
+					rowFailed = true
+				}
+				continue
+			}
+
+			if err := setField(elem.FieldByName(field.structField), cell); err != nil {
+				verr.Errors = append(verr.Errors, &FieldError{
+					Sheet: sheetName, Row: excelRow, Column: field.label(), Err: err,
+				})
+				// An optional field with a garbage cell should leave
+				// that field blank/zero, not reject otherwise-valid
+				// required data in the rest of the row.
+				if field.required {
+					rowFailed = true
+				}
+			}
+		}
+
+		if !rowHasData {
+			continue // trailing blank row
+		}
+		if rowFailed {
+			verr.RowsRejected++
+			continue
+		}
+		sliceVal.Set(reflect.Append(sliceVal, elem))
+	}
+
+	if len(verr.Errors) > 0 {
+		return verr
+	}
+	return nil
+}
+
+// DecodeTolerant decodes r into out like Decode, but treats row-level
+// validation failures as non-fatal: whatever rows were accepted are
+// kept, onRejected (if not nil) is called with the number of rows
+// dropped, and nil is returned instead of the *ValidationError. Any
+// other error from Decode is returned unchanged.
+func DecodeTolerant(r io.Reader, out any, onRejected func(rowsRejected int)) error {
+	err := Decode(r, out)
+	if err == nil {
+		return nil
+	}
+
+	if verr, ok := err.(*ValidationError); ok {
+		if onRejected != nil {
+			onRejected(verr.RowsRejected)
+		}
+		return nil
+	}
+	return err
+}
+
+// findSheet returns the rows of the first candidate sheet name that
+// exists in f.
+func findSheet(f *excelize.File, candidates []string) (string, [][]string, error) {
+	for _, name := range candidates {
+		rows, err := f.GetRows(name)
+		if err == nil {
+			return name, rows, nil
+		}
+	}
+	return "", nil, fmt.Errorf("excelmap: none of the candidate sheets %v were found", candidates)
+}
+
+// findHeader locates the header row by matching match against any cell
+// (case-insensitively). An empty match falls back to treating the very
+// first row as the header.
+func findHeader(rows [][]string, match string) (int, []string) {
+	if match == "" {
+		if len(rows) == 0 {
+			return -1, nil
+		}
+		return 0, rows[0]
+	}
+
+	match = strings.ToLower(match)
+	for i, row := range rows {
+		for _, cell := range row {
+			if strings.Contains(strings.ToLower(cell), match) {
+				return i, row
+			}
+		}
+	}
+	return -1, nil
+}
+
+// resolveColumns maps each header-addressed field to the column index
+// of its matching header cell.
+func resolveColumns(header []string, fields []fieldSpec) map[string]int {
+	columns := make(map[string]int, len(fields))
+	for _, field := range fields {
+		if field.header == "" {
+			continue
+		}
+		for i, cell := range header {
+			if strings.EqualFold(strings.TrimSpace(cell), field.header) {
+				columns[field.structField] = i
+				break
+			}
+		}
+	}
+	return columns
+}
+
+// CellSetter lets a field type take over parsing its own raw cell
+// value, for types reflection can't coerce on its own (e.g. NullFloat64).
+type CellSetter interface {
+	SetCell(raw string) error
+}
+
+// setField coerces cell into fv's type, supporting the handful of kinds
+// the current report structs use.
+func setField(fv reflect.Value, cell string) error {
+	if fv.CanAddr() {
+		if setter, ok := fv.Addr().Interface().(CellSetter); ok {
+			return setter.SetCell(cell)
+		}
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(cell)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(strings.ReplaceAll(cell, ",", ""), 64)
+		if err != nil {
+			return fmt.Errorf("expected a number, got %q", cell)
+		}
+		fv.SetFloat(n)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(cell, 10, 64)
+		if err != nil {
+			return fmt.Errorf("expected an integer, got %q", cell)
+		}
+		fv.SetInt(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(cell)
+		if err != nil {
+			return fmt.Errorf("expected a boolean, got %q", cell)
+		}
+		fv.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field kind %s", fv.Kind())
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package excelmap
+
+import (
+	"bytes"
+	"testing"
+
+	excelize "github.com/xuri/excelize/v2"
+)
+
+// newWorkbook builds a single-sheet XLSX in memory for Decode tests.
+func newWorkbook(t *testing.T, sheet string, headers []string, rows [][]string) []byte {
+	t.Helper()
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName("Sheet1", sheet); err != nil {
+		t.Fatalf("rename sheet: %v", err)
+	}
+	for col, h := range headers {
+		cell, _ := excelize.CoordinatesToCellName(col+1, 1)
+		f.SetCellValue(sheet, cell, h)
+	}
+	for r, row := range rows {
+		for col, v := range row {
+			cell, _ := excelize.CoordinatesToCellName(col+1, r+2)
+			f.SetCellValue(sheet, cell, v)
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		t.Fatalf("write workbook: %v", err)
+	}
+	return buf.Bytes()
+}
+
+type decodeTestRow struct {
+	Name  string  `excel:"header=Name,required"`
+	Score float64 `excel:"header=Score"`
+}
+
+func init() {
+	Register(decodeTestRow{}, SheetSpec{Sheets: []string{"Main"}, HeaderMatch: "Name"})
+}
+
+func TestDecode_RequiredFieldMissingRejectsRow(t *testing.T) {
+	data := newWorkbook(t, "Main", []string{"Name", "Score"}, [][]string{
+		{"", "1"},
+	})
+
+	var rows []decodeTestRow
+	err := Decode(bytes.NewReader(data), &rows)
+
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %v", err)
+	}
+	if verr.RowsRejected != 1 {
+		t.Errorf("RowsRejected = %d, want 1", verr.RowsRejected)
+	}
+	if len(rows) != 0 {
+		t.Errorf("rows = %v, want none decoded", rows)
+	}
+}
+
+func TestDecode_OptionalFieldBadCellKeepsRow(t *testing.T) {
+	data := newWorkbook(t, "Main", []string{"Name", "Score"}, [][]string{
+		{"Alice", "not-a-number"},
+	})
+
+	var rows []decodeTestRow
+	err := Decode(bytes.NewReader(data), &rows)
+
+	verr, ok := err.(*ValidationError)
+	if !ok {
+		t.Fatalf("expected *ValidationError, got %v", err)
+	}
+	if verr.RowsRejected != 0 {
+		t.Errorf("RowsRejected = %d, want 0 (row has required data)", verr.RowsRejected)
+	}
+	if len(rows) != 1 || rows[0].Name != "Alice" || rows[0].Score != 0 {
+		t.Errorf("rows = %v, want one row for Alice with Score left at zero", rows)
+	}
+}
+
+func TestDecode_NumericCoercion(t *testing.T) {
+	data := newWorkbook(t, "Main", []string{"Name", "Score"}, [][]string{
+		{"Bob", "1,234.5"},
+	})
+
+	var rows []decodeTestRow
+	if err := Decode(bytes.NewReader(data), &rows); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(rows) != 1 || rows[0].Score != 1234.5 {
+		t.Errorf("rows = %v, want Score 1234.5", rows)
+	}
+}
+
+type headerMismatchRow struct {
+	Name string `excel:"header=Name,required"`
+}
+
+func init() {
+	Register(headerMismatchRow{}, SheetSpec{Sheets: []string{"Mismatch"}, HeaderMatch: "Does Not Exist"})
+}
+
+func TestDecode_HeaderNotFound(t *testing.T) {
+	data := newWorkbook(t, "Mismatch", []string{"Name"}, [][]string{
+		{"Alice"},
+	})
+
+	var rows []headerMismatchRow
+	err := Decode(bytes.NewReader(data), &rows)
+	if err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+	if _, ok := err.(*ValidationError); ok {
+		t.Fatalf("expected a plain error, got *ValidationError: %v", err)
+	}
+}
+
+type fallbackSheetRow struct {
+	Name string `excel:"header=Name,required"`
+}
+
+func init() {
+	Register(fallbackSheetRow{}, SheetSpec{Sheets: []string{"Primary", "Fallback"}, HeaderMatch: "Name"})
+}
+
+func TestDecode_MultiCandidateSheetFallback(t *testing.T) {
+	data := newWorkbook(t, "Fallback", []string{"Name"}, [][]string{
+		{"Carol"},
+	})
+
+	var rows []fallbackSheetRow
+	if err := Decode(bytes.NewReader(data), &rows); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if len(rows) != 1 || rows[0].Name != "Carol" {
+		t.Errorf("rows = %v, want one row for Carol from the fallback sheet", rows)
+	}
+}
@@ -0,0 +1,30 @@
+package excelmap
+
+import "fmt"
+
+// FieldError describes a single failed cell found while decoding a sheet.
+type FieldError struct {
+	Sheet  string
+	Row    int // 1-based, matching the row number Excel itself shows
+	Column string
+	Err    error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: row %d, column %q: %v", e.Sheet, e.Row, e.Column, e.Err)
+}
+
+// ValidationError collects every FieldError found while decoding a
+// sheet, so a bad upload can be reported to the user in full instead of
+// failing on the first bad row.
+type ValidationError struct {
+	Errors       []*FieldError
+	RowsRejected int
+}
+
+func (e *ValidationError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	return fmt.Sprintf("%d validation errors, first: %v", len(e.Errors), e.Errors[0])
+}
@@ -0,0 +1,31 @@
+// Package excelmap decodes XLSX rows into structs by reading `excel`
+// struct tags, replacing the hardcoded sheet names and column indexes
+// that used to live inline in each controller.
+package excelmap
+
+import "reflect"
+
+// SheetSpec declares how to locate and decode a sheet for a struct type:
+// which sheet(s) to look for and how to recognize the header row.
+type SheetSpec struct {
+	// Sheets lists candidate sheet names to try, in the order given.
+	Sheets []string
+	// HeaderMatch is text expected somewhere in the header row, used to
+	// locate it by content instead of assuming a fixed skip count. Leave
+	// empty to treat the very first row as the header.
+	HeaderMatch string
+}
+
+var registry = map[reflect.Type]SheetSpec{}
+
+// Register associates a SheetSpec with the type of example. Call it
+// once, typically from an init(), for every struct Decode should know
+// how to parse:
+//
+//	excelmap.Register(ExlProduct{}, excelmap.SheetSpec{
+//		Sheets:      []string{"APL", "DaftarHarga"},
+//		HeaderMatch: "KODE APL",
+//	})
+func Register(example any, spec SheetSpec) {
+	registry[reflect.TypeOf(example)] = spec
+}
@@ -0,0 +1,52 @@
+package excelmap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NullFloat64 is a numeric cell that may be blank. It replaces fields
+// that used to be padded with a literal " " by handleNullValue, so
+// downstream code can tell "zero" apart from "missing" and do real math
+// on the value.
+type NullFloat64 struct {
+	Float64 float64
+	Valid   bool
+}
+
+// SetCell implements CellSetter.
+func (n *NullFloat64) SetCell(raw string) error {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		*n = NullFloat64{}
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(strings.ReplaceAll(raw, ",", ""), 64)
+	if err != nil {
+		return fmt.Errorf("expected a number, got %q", raw)
+	}
+	*n = NullFloat64{Float64: v, Valid: true}
+	return nil
+}
+
+// String formats n for display, rendering an empty string when not
+// Valid so templates keep showing blanks the way they used to.
+func (n NullFloat64) String() string {
+	if !n.Valid {
+		return ""
+	}
+	return strconv.FormatFloat(n.Float64, 'f', -1, 64)
+}
+
+// Ptr returns nil when n isn't Valid, and a pointer to its value
+// otherwise, for persisting into nullable database columns without
+// collapsing a missing cell into a stored zero.
+func (n NullFloat64) Ptr() *float64 {
+	if !n.Valid {
+		return nil
+	}
+	v := n.Float64
+	return &v
+}
@@ -0,0 +1,53 @@
+package excelmap
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// fieldSpec is the parsed form of a single field's `excel` tag.
+type fieldSpec struct {
+	structField string
+	header      string
+	index       int // column index, or -1 if the field is resolved by header
+	required    bool
+}
+
+// label returns whatever identifies the column in error messages.
+func (f fieldSpec) label() string {
+	if f.header != "" {
+		return f.header
+	}
+	return strconv.Itoa(f.index)
+}
+
+// parseFields reads the `excel` tag off every exported field of
+// elemType. Fields without a tag are left out of the mapping entirely.
+func parseFields(elemType reflect.Type) []fieldSpec {
+	var fields []fieldSpec
+	for i := 0; i < elemType.NumField(); i++ {
+		sf := elemType.Field(i)
+		tag, ok := sf.Tag.Lookup("excel")
+		if !ok {
+			continue
+		}
+
+		field := fieldSpec{structField: sf.Name, index: -1}
+		for _, part := range strings.Split(tag, ",") {
+			part = strings.TrimSpace(part)
+			switch {
+			case part == "required":
+				field.required = true
+			case strings.HasPrefix(part, "header="):
+				field.header = strings.TrimPrefix(part, "header=")
+			case strings.HasPrefix(part, "index="):
+				if n, err := strconv.Atoi(strings.TrimPrefix(part, "index=")); err == nil {
+					field.index = n
+				}
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
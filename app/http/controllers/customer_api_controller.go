@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+
+	"goravel/app/models"
+)
+
+type CustomerAPIController struct {
+	//Dependent services
+}
+
+func NewCustomerAPIController() *CustomerAPIController {
+	return &CustomerAPIController{
+		//Inject services
+	}
+}
+
+// Index handles GET /api/customers?branch=...&sales=...&page=...&per_page=...,
+// querying the indexed customers table instead of scanning an
+// in-memory slice.
+func (r *CustomerAPIController) Index(ctx http.Context) http.Response {
+	query := facades.Orm().Query().Model(&models.Customer{})
+
+	if branch := ctx.Request().Input("branch"); branch != "" {
+		query = query.Where("branch", branch)
+	}
+	if sales := ctx.Request().Input("sales"); sales != "" {
+		query = query.Where("sales_name", sales)
+	}
+
+	page := parsePositiveInt(ctx.Request().Input("page"), 1)
+	perPage := parsePositiveInt(ctx.Request().Input("per_page"), 25)
+
+	var total int64
+	if err := query.Count(&total); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
+			"message": err,
+		})
+	}
+
+	var customers []models.Customer
+	if err := query.Offset((page - 1) * perPage).Limit(perPage).Find(&customers); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
+			"message": err,
+		})
+	}
+
+	return ctx.Response().Json(http.StatusOK, http.Json{
+		"data":     customers,
+		"page":     page,
+		"per_page": perPage,
+		"total":    total,
+	})
+}
+
+// Show handles GET /api/customers/{custId}, looking the customer up by
+// the customers table's unique index instead of scanning every row.
+func (r *CustomerAPIController) Show(ctx http.Context) http.Response {
+	custID := ctx.Request().Route("custId")
+
+	customer, err := FindCustomerByCustID(custID)
+	if err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
+			"message": err,
+		})
+	}
+	if customer == nil {
+		return ctx.Response().Json(http.StatusNotFound, http.Json{
+			"message": "customer not found",
+		})
+	}
+
+	return ctx.Response().Json(http.StatusOK, http.Json{
+		"data": customer,
+	})
+}
@@ -0,0 +1,25 @@
+package controllers
+
+import (
+	"github.com/goravel/framework/contracts/http"
+)
+
+type HealthController struct {
+	//Dependent services
+}
+
+func NewHealthController() *HealthController {
+	return &HealthController{
+		//Inject services
+	}
+}
+
+// Index reports basic liveness plus workbook cache stats, so operators
+// can see hit rate and last-refresh timestamps without digging through
+// logs.
+func (r *HealthController) Index(ctx http.Context) http.Response {
+	return ctx.Response().Json(http.StatusOK, http.Json{
+		"status": "ok",
+		"cache":  sharedWorkbookCache.Stats(),
+	})
+}
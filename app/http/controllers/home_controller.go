@@ -1,16 +1,16 @@
 package controllers
 
 import (
+	"bytes"
+	"context"
 	"time"
 
 	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
 
-	"bytes"
-	"fmt"
-	"io/ioutil"
-	https "net/http"
-
-	excelize "github.com/xuri/excelize/v2"
+	"goravel/app/datasource"
+	"goravel/app/excelmap"
+	"goravel/app/models"
 )
 
 type HomeController struct {
@@ -24,12 +24,6 @@ func NewHomeController() *HomeController {
 }
 
 func (r *HomeController) Update(ctx http.Context) http.Response {
-	// data, err := openURLCust("https://www.dropbox.com/scl/fi/ga9aesugfhxrt2dmuknre/Data-base-aplikasi-bayer-joglopwk-160224.xlsx?rlkey=4x85x8rdq9r3x7wyzgxjnofki&dl=1")
-	// if err != nil {
-	// 	return ctx.Response().Json(http.StatusInternalServerError, http.Json{
-	// 		"message": err,
-	// 	})
-	// }
 	input := ctx.Request().Input("input")
 
 	// Do something with the text (e.g., print it)
@@ -39,13 +33,13 @@ func (r *HomeController) Update(ctx http.Context) http.Response {
 }
 
 func (r *HomeController) Index(ctx http.Context) http.Response {
-	data, err := openURLCust("https://www.dropbox.com/scl/fi/ga9aesugfhxrt2dmuknre/Data-base-aplikasi-bayer-joglopwk-160224.xlsx?rlkey=4x85x8rdq9r3x7wyzgxjnofki&dl=1")
+	data, err := loadCustomers(ctx.Context())
 	if err != nil {
 		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
 			"message": err,
 		})
 	}
-	dataProduct, err := openURLItem("https://www.dropbox.com/scl/fi/s74lf3gtp77r7f5rey3ps/Daftar-Harga-jan-24.xlsx?rlkey=wr368rzy7gcy5usvm7dk6otyq&dl=1")
+	dataProduct, err := loadProducts(ctx.Context())
 	if err != nil {
 		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
 			"message": err,
@@ -61,167 +55,100 @@ func (r *HomeController) Index(ctx http.Context) http.Response {
 }
 
 type ExlProduct struct {
-	Code        string
-	NameProduct string
-	HNA         string
-	PPN         string
+	Code        string               `excel:"header=KODE APL,required"`
+	NameProduct string               `excel:"header=PRODUK,required"`
+	HNA         excelmap.NullFloat64 `excel:"header=HNA,required"`
+	PPN         excelmap.NullFloat64 `excel:"header=PPN"`
 }
 type ExlData struct {
-	Branch    string
-	CustId    string
-	CustName  string
-	Alamat    string
-	Kota      string
-	SalesName string
-	Channel   string
-	Avg2023   string
-	Q4Avg2023 string
-}
-type PageData struct {
-	Customers []ExlData
+	Branch    string               `excel:"index=0,required"`
+	CustId    string               `excel:"index=1,required"`
+	CustName  string               `excel:"index=2,required"`
+	Alamat    string               `excel:"index=3"`
+	Kota      string               `excel:"index=4"`
+	SalesName string               `excel:"index=5"`
+	Channel   string               `excel:"index=6"`
+	Avg2023   excelmap.NullFloat64 `excel:"index=7"`
+	Q4Avg2023 excelmap.NullFloat64 `excel:"index=8"`
 }
 
-func (data PageData) FindByID(id string) *ExlData {
-	for _, customer := range data.Customers {
-		if customer.CustId == id {
-			return &customer
-		}
-	}
-	return nil
+func init() {
+	// Customer sheets don't carry recognizable header text across the
+	// workbooks we've seen, so fall back to treating row 1 as the
+	// header and addressing columns by position.
+	excelmap.Register(ExlData{}, excelmap.SheetSpec{
+		Sheets: []string{"Data Base", "Sheet1"},
+	})
+	excelmap.Register(ExlProduct{}, excelmap.SheetSpec{
+		Sheets:      []string{"APL", "DaftarHarga"},
+		HeaderMatch: "KODE APL",
+	})
 }
 
-func openURLCust(urlLink string) ([]ExlData, error) {
-	var exlData []ExlData
-	data, err := getData(urlLink)
-	if err != nil {
-		panic(err)
-	}
-
-	// Open the ZIP file with Excelize
-	exlz, err := excelize.OpenReader(bytes.NewReader(data))
-	if err != nil {
-		fmt.Println("Reader", err)
-		return nil, err
-	}
-
-	lst := exlz.GetSheetList()
-	if len(lst) == 0 {
-		fmt.Println("Empty document")
+// FindCustomerByCustID looks up a single customer by CustId using the
+// customers table's unique index, replacing the old O(N) scan over an
+// in-memory PageData.Customers slice.
+func FindCustomerByCustID(custID string) (*models.Customer, error) {
+	var customer models.Customer
+	if err := facades.Orm().Query().Where("cust_id", custID).First(&customer); err != nil {
 		return nil, err
 	}
-
-	fmt.Println("Sheet list:")
-	for _, s := range lst {
-		fmt.Println(s)
+	if customer.ID == 0 {
+		return nil, nil
 	}
+	return &customer, nil
+}
 
-	defer func() {
-		if err = exlz.Close(); err != nil {
-			fmt.Println(err)
-		}
-	}()
-
-	fmt.Println("Done")
-	rows, err := exlz.GetRows("Sheet1")
+func loadCustomers(ctx context.Context) ([]ExlData, error) {
+	src, err := datasource.Resolve(facades.Config(), "customers")
 	if err != nil {
-		fmt.Println(err)
 		return nil, err
 	}
-
-	// Iterate over rows and populate the model
-	isFirstRow := true
-	for _, row := range rows {
-		if isFirstRow {
-			isFirstRow = false
-			continue
-		}
-		rowData := ExlData{
-			Branch:    handleNullValue(row[0]),
-			CustId:    handleNullValue(row[1]),
-			CustName:  handleNullValue(row[2]),
-			Alamat:    handleNullValue(row[3]),
-			Kota:      handleNullValue(row[4]),
-			SalesName: handleNullValue(row[5]),
-			Channel:   handleNullValue(row[6]),
-			Avg2023:   handleNullValue(row[7]),
-			Q4Avg2023: handleNullValue(row[8]),
-		}
-		exlData = append(exlData, rowData)
-	}
-	_ = PageData{
-		Customers: exlData,
-	}
-	return exlData, nil
-}
-func openURLItem(urlLink string) ([]ExlProduct, error) {
-	var exlData []ExlProduct
-	data, err := getData(urlLink)
-	if err != nil {
-		panic(err)
-	}
-
-	// Open the ZIP file with Excelize
-	exlz, err := excelize.OpenReader(bytes.NewReader(data))
+	data, err := sharedWorkbookCache.Get(ctx, "customers", src, func(body []byte) (any, error) {
+		return parseCustomers(body)
+	})
 	if err != nil {
-		fmt.Println("Reader", err)
 		return nil, err
 	}
+	return data.([]ExlData), nil
+}
 
-	lst := exlz.GetSheetList()
-	if len(lst) == 0 {
-		fmt.Println("Empty document")
+func loadProducts(ctx context.Context) ([]ExlProduct, error) {
+	src, err := datasource.Resolve(facades.Config(), "products")
+	if err != nil {
 		return nil, err
 	}
-
-	fmt.Println("Sheet list:")
-	for _, s := range lst {
-		fmt.Println(s)
-	}
-
-	defer func() {
-		if err = exlz.Close(); err != nil {
-			fmt.Println(err)
-		}
-	}()
-
-	fmt.Println("Done")
-	rows, err := exlz.GetRows("DaftarHarga")
+	data, err := sharedWorkbookCache.Get(ctx, "products", src, func(body []byte) (any, error) {
+		return parseProducts(body)
+	})
 	if err != nil {
-		fmt.Println(err)
 		return nil, err
 	}
+	return data.([]ExlProduct), nil
+}
 
-	// Iterate over rows and populate the model
-	for index, row := range rows {
-		if index < 7 {
-			continue
-		}
-		rowData := ExlProduct{
-			Code:        handleNullValue(row[0]),
-			NameProduct: handleNullValue(row[1]),
-			HNA:         handleNullValue(row[2]),
-			PPN:         handleNullValue(row[3]),
-		}
-		exlData = append(exlData, rowData)
+func parseCustomers(data []byte) ([]ExlData, error) {
+	var exlData []ExlData
+	if err := decodeTolerant(data, &exlData); err != nil {
+		return nil, err
 	}
 	return exlData, nil
 }
 
-func getData(url string) ([]byte, error) {
-
-	r, err := https.Get(url)
-	if err != nil {
-		panic(err)
+func parseProducts(data []byte) ([]ExlProduct, error) {
+	var exlData []ExlProduct
+	if err := decodeTolerant(data, &exlData); err != nil {
+		return nil, err
 	}
-
-	defer r.Body.Close()
-
-	return ioutil.ReadAll(r.Body)
+	return exlData, nil
 }
 
-func handleNullValue(value string) string {
-	if value == "" {
-		return " "
-	}
-	return value
+// decodeTolerant decodes data into out, keeping whatever rows
+// excelmap.Decode managed to accept even when some rows failed
+// validation. A handful of bad cells in a large workbook shouldn't 500
+// the whole page; the dropped rows are logged instead.
+func decodeTolerant(data []byte, out any) error {
+	return excelmap.DecodeTolerant(bytes.NewReader(data), out, func(rowsRejected int) {
+		facades.Log().Warningf("excelmap: skipped %d invalid rows", rowsRejected)
+	})
 }
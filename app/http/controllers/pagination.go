@@ -0,0 +1,13 @@
+package controllers
+
+import "strconv"
+
+// parsePositiveInt parses raw as a positive int, falling back to
+// fallback when raw is empty or not a valid positive integer.
+func parsePositiveInt(raw string, fallback int) int {
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 {
+		return fallback
+	}
+	return n
+}
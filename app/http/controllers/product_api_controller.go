@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"github.com/goravel/framework/contracts/http"
+	"github.com/goravel/framework/facades"
+
+	"goravel/app/models"
+)
+
+type ProductAPIController struct {
+	//Dependent services
+}
+
+func NewProductAPIController() *ProductAPIController {
+	return &ProductAPIController{
+		//Inject services
+	}
+}
+
+// Index handles GET /api/products?code=...&page=...&per_page=..., where
+// code matches a products.code prefix.
+func (r *ProductAPIController) Index(ctx http.Context) http.Response {
+	query := facades.Orm().Query().Model(&models.Product{})
+
+	if code := ctx.Request().Input("code"); code != "" {
+		query = query.Where("code LIKE ?", code+"%")
+	}
+
+	page := parsePositiveInt(ctx.Request().Input("page"), 1)
+	perPage := parsePositiveInt(ctx.Request().Input("per_page"), 25)
+
+	var total int64
+	if err := query.Count(&total); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
+			"message": err,
+		})
+	}
+
+	var products []models.Product
+	if err := query.Offset((page - 1) * perPage).Limit(perPage).Find(&products); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
+			"message": err,
+		})
+	}
+
+	return ctx.Response().Json(http.StatusOK, http.Json{
+		"data":     products,
+		"page":     page,
+		"per_page": perPage,
+		"total":    total,
+	})
+}
@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/goravel/framework/contracts/http"
+	excelize "github.com/xuri/excelize/v2"
+)
+
+// ReportController serves the customer and product exports. Customers
+// and products are exported separately rather than joined into one
+// combined report: ExlData and ExlProduct share no key (no customer
+// field identifies which products it buys), so there's nothing real to
+// join them on without a transactional sales record linking the two.
+type ReportController struct {
+	//Dependent services
+}
+
+func NewReportController() *ReportController {
+	return &ReportController{
+		//Inject services
+	}
+}
+
+// reportFilters are the query-string filters shared by every report
+// endpoint: branch/sales/channel narrow the customer rows, code narrows
+// products by their code prefix.
+type reportFilters struct {
+	branch     string
+	salesName  string
+	channel    string
+	codePrefix string
+}
+
+func filtersFromRequest(ctx http.Context) reportFilters {
+	return reportFilters{
+		branch:     ctx.Request().Input("branch"),
+		salesName:  ctx.Request().Input("sales"),
+		channel:    ctx.Request().Input("channel"),
+		codePrefix: ctx.Request().Input("code"),
+	}
+}
+
+func (f reportFilters) matchCustomer(c ExlData) bool {
+	if f.branch != "" && !strings.EqualFold(c.Branch, f.branch) {
+		return false
+	}
+	if f.salesName != "" && !strings.EqualFold(c.SalesName, f.salesName) {
+		return false
+	}
+	if f.channel != "" && !strings.EqualFold(c.Channel, f.channel) {
+		return false
+	}
+	return true
+}
+
+func (f reportFilters) matchProduct(p ExlProduct) bool {
+	if f.codePrefix == "" {
+		return true
+	}
+	return strings.HasPrefix(strings.ToUpper(p.Code), strings.ToUpper(f.codePrefix))
+}
+
+// CustomersXLSX serves GET /reports/customers.xlsx.
+func (r *ReportController) CustomersXLSX(ctx http.Context) http.Response {
+	customers, _, err := loadReportData(ctx)
+	if err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
+			"message": err,
+		})
+	}
+	filters := filtersFromRequest(ctx)
+
+	headers := []string{"Branch", "Customer ID", "Customer Name", "Address", "City", "Sales", "Channel", "Avg 2023", "Q4 Avg 2023"}
+	var rows [][]any
+	for _, c := range customers {
+		if !filters.matchCustomer(c) {
+			continue
+		}
+		rows = append(rows, []any{c.Branch, c.CustId, c.CustName, c.Alamat, c.Kota, c.SalesName, c.Channel, c.Avg2023.String(), c.Q4Avg2023.String()})
+	}
+
+	return xlsxResponse(ctx, "customers.xlsx", "Customers", headers, rows)
+}
+
+// CustomersCSV serves GET /reports/customers.csv with the same filters
+// and columns as CustomersXLSX.
+func (r *ReportController) CustomersCSV(ctx http.Context) http.Response {
+	customers, _, err := loadReportData(ctx)
+	if err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
+			"message": err,
+		})
+	}
+	filters := filtersFromRequest(ctx)
+
+	headers := []string{"Branch", "Customer ID", "Customer Name", "Address", "City", "Sales", "Channel", "Avg 2023", "Q4 Avg 2023"}
+	var rows [][]any
+	for _, c := range customers {
+		if !filters.matchCustomer(c) {
+			continue
+		}
+		rows = append(rows, []any{c.Branch, c.CustId, c.CustName, c.Alamat, c.Kota, c.SalesName, c.Channel, c.Avg2023.String(), c.Q4Avg2023.String()})
+	}
+
+	return csvResponse(ctx, "customers.csv", headers, rows)
+}
+
+// ProductsXLSX serves GET /reports/products.xlsx, filtered by the
+// "code" prefix query parameter.
+func (r *ReportController) ProductsXLSX(ctx http.Context) http.Response {
+	_, products, err := loadReportData(ctx)
+	if err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
+			"message": err,
+		})
+	}
+	filters := filtersFromRequest(ctx)
+
+	headers := []string{"Code", "Product Name", "HNA", "PPN"}
+	var rows [][]any
+	for _, p := range products {
+		if !filters.matchProduct(p) {
+			continue
+		}
+		rows = append(rows, []any{p.Code, p.NameProduct, p.HNA.String(), p.PPN.String()})
+	}
+
+	return xlsxResponse(ctx, "products.xlsx", "Products", headers, rows)
+}
+
+func loadReportData(ctx http.Context) ([]ExlData, []ExlProduct, error) {
+	customers, err := loadCustomers(ctx.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+	products, err := loadProducts(ctx.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+	return customers, products, nil
+}
+
+// xlsxResponse streams a workbook with a bold, frozen header row and
+// an auto-filter over the data range.
+func xlsxResponse(ctx http.Context, filename, sheet string, headers []string, rows [][]any) http.Response {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName("Sheet1", sheet); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{"message": err})
+	}
+
+	for col, title := range headers {
+		cell, _ := excelize.CoordinatesToCellName(col+1, 1)
+		f.SetCellValue(sheet, cell, title)
+	}
+	lastCol, _ := excelize.ColumnNumberToName(len(headers))
+	headerStyle, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{"message": err})
+	}
+	if err := f.SetCellStyle(sheet, "A1", lastCol+"1", headerStyle); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{"message": err})
+	}
+	for rowIdx, row := range rows {
+		for col, value := range row {
+			cell, _ := excelize.CoordinatesToCellName(col+1, rowIdx+2)
+			f.SetCellValue(sheet, cell, value)
+		}
+	}
+
+	if err := f.SetPanes(sheet, &excelize.Panes{
+		Freeze:      true,
+		YSplit:      1,
+		TopLeftCell: "A2",
+		ActivePane:  "bottomLeft",
+	}); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{"message": err})
+	}
+
+	filterRange := fmt.Sprintf("A1:%s%d", lastCol, len(rows)+1)
+	if err := f.AutoFilter(sheet, filterRange, nil); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{"message": err})
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{"message": err})
+	}
+
+	return ctx.Response().Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename)).
+		Data(http.StatusOK, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", buf.Bytes())
+}
+
+// csvResponse streams a plain CSV download.
+func csvResponse(ctx http.Context, filename string, headers []string, rows [][]any) http.Response {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write(headers); err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{"message": err})
+	}
+	for _, row := range rows {
+		record := make([]string, len(row))
+		for i, value := range row {
+			record[i] = fmt.Sprint(value)
+		}
+		if err := w.Write(record); err != nil {
+			return ctx.Response().Json(http.StatusInternalServerError, http.Json{"message": err})
+		}
+	}
+	w.Flush()
+
+	return ctx.Response().Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename)).
+		Data(http.StatusOK, "text/csv", buf.Bytes())
+}
@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"reflect"
+
+	"github.com/goravel/framework/contracts/http"
+
+	"goravel/app/excelmap"
+)
+
+type UploadController struct {
+	//Dependent services
+}
+
+func NewUploadController() *UploadController {
+	return &UploadController{
+		//Inject services
+	}
+}
+
+// Upload handles POST /upload: a multipart "file" field holding an XLSX
+// workbook, and a "type" field selecting which schema to decode it
+// against ("customers", the default, or "products").
+func (r *UploadController) Upload(ctx http.Context) http.Response {
+	file, err := ctx.Request().File("file")
+	if err != nil {
+		return ctx.Response().Json(http.StatusBadRequest, http.Json{
+			"message": err,
+		})
+	}
+
+	path, err := file.File()
+	if err != nil {
+		return ctx.Response().Json(http.StatusBadRequest, http.Json{
+			"message": err,
+		})
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ctx.Response().Json(http.StatusInternalServerError, http.Json{
+			"message": err,
+		})
+	}
+
+	var result uploadResult
+	switch ctx.Request().Input("type", "customers") {
+	case "products":
+		var products []ExlProduct
+		result = decodeUpload(data, &products)
+	default:
+		var customers []ExlData
+		result = decodeUpload(data, &customers)
+	}
+
+	return ctx.Response().Json(http.StatusOK, http.Json{
+		"accepted": result.Accepted,
+		"rejected": result.Rejected,
+		"errors":   result.Errors,
+	})
+}
+
+// uploadResult is the JSON summary returned by Upload.
+type uploadResult struct {
+	Accepted int
+	Rejected int
+	Errors   []string
+}
+
+func decodeUpload(data []byte, out any) uploadResult {
+	err := excelmap.Decode(bytes.NewReader(data), out)
+	accepted := reflect.ValueOf(out).Elem().Len()
+
+	var verr *excelmap.ValidationError
+	if errors.As(err, &verr) {
+		messages := make([]string, len(verr.Errors))
+		for i, fieldErr := range verr.Errors {
+			messages[i] = fieldErr.Error()
+		}
+		return uploadResult{Accepted: accepted, Rejected: verr.RowsRejected, Errors: messages}
+	}
+	if err != nil {
+		return uploadResult{Errors: []string{err.Error()}}
+	}
+	return uploadResult{Accepted: accepted}
+}
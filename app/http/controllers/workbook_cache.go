@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/goravel/framework/facades"
+
+	"goravel/app/datasource"
+)
+
+// cacheTTL is how long a cached workbook is served before a background
+// refresh is triggered. Requests keep getting the stale copy while the
+// refresh is in flight (stale-while-revalidate).
+const cacheTTL = 5 * time.Minute
+
+type workbookEntry struct {
+	mu            sync.Mutex
+	data          any
+	revision      string
+	lastRefreshed time.Time
+	refreshing    bool
+}
+
+// workbookCache memoizes parsed workbooks by logical datasource name so
+// /index doesn't re-download and re-parse the whole file on every hit.
+type workbookCache struct {
+	mu      sync.Mutex
+	entries map[string]*workbookEntry
+	hits    int64
+	misses  int64
+}
+
+var sharedWorkbookCache = newWorkbookCache()
+
+func newWorkbookCache() *workbookCache {
+	return &workbookCache{entries: map[string]*workbookEntry{}}
+}
+
+// Get returns the cached, parsed value for name, fetching it through src
+// and decoding it with parse on a cache miss. A stale entry is returned
+// immediately while a fresh copy is fetched in the background.
+func (c *workbookCache) Get(ctx context.Context, name string, src datasource.Source, parse func([]byte) (any, error)) (any, error) {
+	entry := c.entryFor(name)
+
+	entry.mu.Lock()
+	data := entry.data
+	fresh := !entry.lastRefreshed.IsZero() && time.Since(entry.lastRefreshed) < cacheTTL
+	shouldRefreshAsync := data != nil && !fresh && !entry.refreshing
+	if shouldRefreshAsync {
+		entry.refreshing = true
+	}
+	entry.mu.Unlock()
+
+	c.recordAccess(data != nil)
+
+	if data != nil {
+		if shouldRefreshAsync {
+			go func() {
+				if err := c.refresh(context.Background(), entry, src, parse); err != nil {
+					facades.Log().Warningf("workbook cache: background refresh of %q failed: %v", name, err)
+				}
+			}()
+		}
+		return data, nil
+	}
+
+	// Cold cache: the caller is waiting, so fetch synchronously.
+	if err := c.refresh(ctx, entry, src, parse); err != nil {
+		return nil, err
+	}
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	return entry.data, nil
+}
+
+func (c *workbookCache) entryFor(name string) *workbookEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[name]
+	if !ok {
+		entry = &workbookEntry{}
+		c.entries[name] = entry
+	}
+	return entry
+}
+
+func (c *workbookCache) refresh(ctx context.Context, entry *workbookEntry, src datasource.Source, parse func([]byte) (any, error)) error {
+	entry.mu.Lock()
+	revision := entry.revision
+	entry.mu.Unlock()
+
+	defer func() {
+		entry.mu.Lock()
+		entry.refreshing = false
+		entry.mu.Unlock()
+	}()
+
+	var (
+		body    []byte
+		newRev  string
+		changed = true
+		err     error
+	)
+	if cs, ok := src.(datasource.ConditionalSource); ok {
+		body, newRev, changed, err = cs.FetchIfChanged(ctx, revision)
+	} else {
+		body, err = src.Fetch(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		entry.mu.Lock()
+		entry.lastRefreshed = time.Now()
+		entry.mu.Unlock()
+		return nil
+	}
+
+	parsed, err := parse(body)
+	if err != nil {
+		return err
+	}
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	entry.data = parsed
+	entry.revision = newRev
+	entry.lastRefreshed = time.Now()
+	return nil
+}
+
+func (c *workbookCache) recordAccess(hit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if hit {
+		c.hits++
+	} else {
+		c.misses++
+	}
+}
+
+// SourceStats reports the freshness of a single cached entry.
+type SourceStats struct {
+	LastRefreshed time.Time `json:"last_refreshed"`
+	Revision      string    `json:"revision,omitempty"`
+}
+
+// CacheStats is surfaced on /healthz so operators can see hit rate and
+// per-source freshness at a glance.
+type CacheStats struct {
+	Hits    int64                  `json:"hits"`
+	Misses  int64                  `json:"misses"`
+	Sources map[string]SourceStats `json:"sources"`
+}
+
+func (c *workbookCache) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := CacheStats{
+		Hits:    c.hits,
+		Misses:  c.misses,
+		Sources: make(map[string]SourceStats, len(c.entries)),
+	}
+	for name, entry := range c.entries {
+		entry.mu.Lock()
+		stats.Sources[name] = SourceStats{
+			LastRefreshed: entry.lastRefreshed,
+			Revision:      entry.revision,
+		}
+		entry.mu.Unlock()
+	}
+	return stats
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"github.com/goravel/framework/database/orm"
+)
+
+// Customer mirrors the customer rows imported from the workbook
+// configured as datasource.customers. CustId is unique so re-importing
+// the same workbook upserts rather than duplicating rows.
+type Customer struct {
+	orm.Model
+	Branch    string
+	CustId    string `gorm:"uniqueIndex"`
+	CustName  string
+	Alamat    string
+	Kota      string
+	SalesName string
+	Channel   string
+	Avg2023   *float64 `gorm:"column:avg_2023"`
+	Q4Avg2023 *float64 `gorm:"column:q4_avg_2023"`
+	orm.Timestamps
+}
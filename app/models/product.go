@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/goravel/framework/database/orm"
+)
+
+// Product mirrors the product rows imported from the workbook
+// configured as datasource.products. Code is unique so re-importing
+// the same workbook upserts rather than duplicating rows.
+type Product struct {
+	orm.Model
+	Code        string `gorm:"uniqueIndex"`
+	NameProduct string
+	HNA         *float64
+	PPN         *float64
+	orm.Timestamps
+}
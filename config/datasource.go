@@ -0,0 +1,25 @@
+package config
+
+import (
+	"github.com/goravel/framework/facades"
+)
+
+func init() {
+	config := facades.Config()
+
+	config.Add("datasource", map[string]any{
+		// Logical name -> backing location. Swap the uri for an
+		// http(s):// or s3:// value to move off local files without
+		// touching the controllers that read them.
+		"customers": map[string]any{
+			"uri":     config.Env("DATASOURCE_CUSTOMERS_URI", "file://excel/jogja.xlsx"),
+			"timeout": 30,
+			"retries": 2,
+		},
+		"products": map[string]any{
+			"uri":     config.Env("DATASOURCE_PRODUCTS_URI", "file://excel/harga_jogja.xlsx"),
+			"timeout": 30,
+			"retries": 2,
+		},
+	})
+}
@@ -0,0 +1,17 @@
+package database
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+
+	"goravel/database/migrations"
+)
+
+type Kernel struct {
+}
+
+func (kernel Kernel) Migrations() []schema.Migration {
+	return []schema.Migration{
+		&migrations.CreateCustomersTable{},
+		&migrations.CreateProductsTable{},
+	}
+}
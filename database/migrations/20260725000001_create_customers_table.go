@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+	"github.com/goravel/framework/facades"
+)
+
+type CreateCustomersTable struct{}
+
+// Signature The unique signature for the migration.
+func (r *CreateCustomersTable) Signature() string {
+	return "20260725000001_create_customers_table"
+}
+
+// Up Run the migrations.
+func (r *CreateCustomersTable) Up() error {
+	if facades.Schema().HasTable("customers") {
+		return nil
+	}
+
+	return facades.Schema().Create("customers", func(table schema.Blueprint) {
+		table.ID()
+		table.String("branch").Nullable()
+		table.String("cust_id")
+		table.String("cust_name").Nullable()
+		table.String("alamat").Nullable()
+		table.String("kota").Nullable()
+		table.String("sales_name").Nullable()
+		table.String("channel").Nullable()
+		table.Double("avg_2023").Nullable()
+		table.Double("q4_avg_2023").Nullable()
+		table.TimestampsTz()
+
+		table.Unique("cust_id")
+		table.Index("branch")
+		table.Index("sales_name")
+	})
+}
+
+// Down Reverse the migrations.
+func (r *CreateCustomersTable) Down() error {
+	return facades.Schema().DropIfExists("customers")
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"github.com/goravel/framework/contracts/database/schema"
+	"github.com/goravel/framework/facades"
+)
+
+type CreateProductsTable struct{}
+
+// Signature The unique signature for the migration.
+func (r *CreateProductsTable) Signature() string {
+	return "20260725000002_create_products_table"
+}
+
+// Up Run the migrations.
+func (r *CreateProductsTable) Up() error {
+	if facades.Schema().HasTable("products") {
+		return nil
+	}
+
+	return facades.Schema().Create("products", func(table schema.Blueprint) {
+		table.ID()
+		table.String("code")
+		table.String("name_product").Nullable()
+		table.Double("hna").Nullable()
+		table.Double("ppn").Nullable()
+		table.TimestampsTz()
+
+		table.Unique("code")
+	})
+}
+
+// Down Reverse the migrations.
+func (r *CreateProductsTable) Down() error {
+	return facades.Schema().DropIfExists("products")
+}
@@ -18,4 +18,22 @@ func Web() {
 
 	homeController := controllers.NewHomeController()
 	facades.Route().Get("/index", homeController.Index)
+
+	healthController := controllers.NewHealthController()
+	facades.Route().Get("/healthz", healthController.Index)
+
+	reportController := controllers.NewReportController()
+	facades.Route().Get("/reports/customers.xlsx", reportController.CustomersXLSX)
+	facades.Route().Get("/reports/customers.csv", reportController.CustomersCSV)
+	facades.Route().Get("/reports/products.xlsx", reportController.ProductsXLSX)
+
+	uploadController := controllers.NewUploadController()
+	facades.Route().Post("/upload", uploadController.Upload)
+
+	customerAPIController := controllers.NewCustomerAPIController()
+	facades.Route().Get("/api/customers", customerAPIController.Index)
+	facades.Route().Get("/api/customers/{custId}", customerAPIController.Show)
+
+	productAPIController := controllers.NewProductAPIController()
+	facades.Route().Get("/api/products", productAPIController.Index)
 }